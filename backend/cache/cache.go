@@ -0,0 +1,155 @@
+// Package cache memoizes graph reads (the full graph and per-node
+// subgraphs) behind a TTL, with a cooldown window that coalesces
+// concurrent misses for the same key into a single load.
+package cache
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// DefaultTTL is how long an entry stays valid when Cache is built
+	// with a zero ttl.
+	DefaultTTL = 60 * time.Second
+
+	// DefaultCooldown is how long a finished load stays registered so
+	// misses racing in right after it still coalesce onto it.
+	DefaultCooldown = 50 * time.Millisecond
+)
+
+type entry struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+// call represents a load in flight (or in its cooldown window) for a key.
+type call struct {
+	wg    sync.WaitGroup
+	value interface{}
+	err   error
+}
+
+// Cache is a TTL'd key/value memoization layer safe for concurrent use.
+type Cache struct {
+	mu       sync.RWMutex
+	entries  map[string]entry
+	ttl      time.Duration
+	cooldown time.Duration
+
+	callMu sync.Mutex
+	calls  map[string]*call
+
+	hits   uint64
+	misses uint64
+}
+
+// New builds a Cache with the given ttl and cooldown. A zero ttl or
+// cooldown falls back to DefaultTTL / DefaultCooldown.
+func New(ttl, cooldown time.Duration) *Cache {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	if cooldown <= 0 {
+		cooldown = DefaultCooldown
+	}
+
+	return &Cache{
+		entries:  make(map[string]entry),
+		ttl:      ttl,
+		cooldown: cooldown,
+		calls:    make(map[string]*call),
+	}
+}
+
+// GetOrLoad returns the cached value for key if it's still fresh,
+// otherwise calls load and caches the result. Concurrent misses for the
+// same key within the cooldown window share one load call.
+func (c *Cache) GetOrLoad(key string, load func() (interface{}, error)) (interface{}, error) {
+	if v, ok := c.get(key); ok {
+		return v, nil
+	}
+
+	c.callMu.Lock()
+	if existing, ok := c.calls[key]; ok {
+		c.callMu.Unlock()
+		existing.wg.Wait()
+		return existing.value, existing.err
+	}
+
+	cl := &call{}
+	cl.wg.Add(1)
+	c.calls[key] = cl
+	c.callMu.Unlock()
+
+	atomic.AddUint64(&c.misses, 1)
+	cl.value, cl.err = load()
+	if cl.err == nil {
+		c.set(key, cl.value)
+	}
+	cl.wg.Done()
+
+	time.AfterFunc(c.cooldown, func() {
+		c.callMu.Lock()
+		delete(c.calls, key)
+		c.callMu.Unlock()
+	})
+
+	return cl.value, cl.err
+}
+
+func (c *Cache) get(key string) (interface{}, bool) {
+	c.mu.RLock()
+	e, ok := c.entries[key]
+	c.mu.RUnlock()
+	if !ok || time.Now().After(e.expiresAt) {
+		return nil, false
+	}
+
+	atomic.AddUint64(&c.hits, 1)
+	return e.value, true
+}
+
+func (c *Cache) set(key string, value interface{}) {
+	c.mu.Lock()
+	c.entries[key] = entry{value: value, expiresAt: time.Now().Add(c.ttl)}
+	c.mu.Unlock()
+}
+
+// Invalidate drops every cached key with the given prefix, e.g. "graph:"
+// to drop the full graph and every per-node subgraph after a write. This
+// also drops any matching key still sitting in its post-load cooldown
+// window, so a GetOrLoad racing right behind Invalidate re-queries instead
+// of coalescing onto the pre-invalidation value.
+func (c *Cache) Invalidate(prefix string) {
+	c.mu.Lock()
+	for k := range c.entries {
+		if strings.HasPrefix(k, prefix) {
+			delete(c.entries, k)
+		}
+	}
+	c.mu.Unlock()
+
+	c.callMu.Lock()
+	for k := range c.calls {
+		if strings.HasPrefix(k, prefix) {
+			delete(c.calls, k)
+		}
+	}
+	c.callMu.Unlock()
+}
+
+// Stats reports cumulative hit/miss counters.
+type Stats struct {
+	Hits   uint64 `json:"hits"`
+	Misses uint64 `json:"misses"`
+}
+
+func (c *Cache) Stats() Stats {
+	return Stats{
+		Hits:   atomic.LoadUint64(&c.hits),
+		Misses: atomic.LoadUint64(&c.misses),
+	}
+}
@@ -0,0 +1,36 @@
+package cache
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestInvalidateDuringCooldownForcesReload(t *testing.T) {
+	c := New(time.Minute, 50*time.Millisecond)
+
+	var loads int64
+	load := func() (interface{}, error) {
+		atomic.AddInt64(&loads, 1)
+		return "value", nil
+	}
+
+	if _, err := c.GetOrLoad("graph:all", load); err != nil {
+		t.Fatalf("GetOrLoad: %v", err)
+	}
+	if n := atomic.LoadInt64(&loads); n != 1 {
+		t.Fatalf("loads = %d, want 1", n)
+	}
+
+	// Invalidate while the finished call is still sitting in its cooldown
+	// window; a GetOrLoad racing in right behind it must not coalesce onto
+	// the pre-invalidation value.
+	c.Invalidate("graph:")
+
+	if _, err := c.GetOrLoad("graph:all", load); err != nil {
+		t.Fatalf("GetOrLoad: %v", err)
+	}
+	if n := atomic.LoadInt64(&loads); n != 2 {
+		t.Fatalf("loads = %d after invalidate, want 2 (cache returned stale cooldown value)", n)
+	}
+}
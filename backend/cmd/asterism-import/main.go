@@ -0,0 +1,135 @@
+// Command asterism-import upserts nodes and links into the asterism
+// database from external sources.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/limpido/asterism/backend/config"
+	"github.com/limpido/asterism/backend/db"
+	"github.com/limpido/asterism/backend/importers"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(1)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "file":
+		err = runFile(os.Args[2:])
+	case "openlibrary":
+		err = runOpenLibrary(os.Args[2:])
+	case "sentiment":
+		err = runSentiment(os.Args[2:])
+	default:
+		usage()
+		os.Exit(1)
+	}
+
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: asterism-import <file|openlibrary|sentiment> [flags]")
+}
+
+func connect(envPath string) (*db.DB, error) {
+	cfg, err := config.Load(envPath)
+	if err != nil {
+		return nil, fmt.Errorf("load config: %w", err)
+	}
+	return db.New(cfg.POSTGRES_DRIVER, cfg.POSTGRES_SOURCE)
+}
+
+func runFile(args []string) error {
+	fs := flag.NewFlagSet("file", flag.ExitOnError)
+	path := fs.String("path", "", "path to a .json or .csv file to import")
+	envPath := fs.String("env", "app.env", "path to the app.env config file")
+	dryRun := fs.Bool("dry-run", false, "print what would be upserted without writing to the db")
+	fs.Parse(args)
+
+	if *path == "" {
+		return fmt.Errorf("file: --path is required")
+	}
+
+	database, err := connect(*envPath)
+	if err != nil {
+		return err
+	}
+	defer database.Close()
+
+	nodes, links, err := importers.NewFileImporter(*path).Fetch(context.Background())
+	if err != nil {
+		return err
+	}
+
+	if err := importers.UpsertNodes(database, nodes, *dryRun); err != nil {
+		return err
+	}
+	return importers.UpsertLinks(database, links, *dryRun)
+}
+
+func runOpenLibrary(args []string) error {
+	fs := flag.NewFlagSet("openlibrary", flag.ExitOnError)
+	baseURL := fs.String("base-url", "", "Open Library base URL (defaults to https://openlibrary.org)")
+	subject := fs.String("subject", "", "Open Library subject to import, e.g. science_fiction")
+	envPath := fs.String("env", "app.env", "path to the app.env config file")
+	dryRun := fs.Bool("dry-run", false, "print what would be upserted without writing to the db")
+	fs.Parse(args)
+
+	if *subject == "" {
+		return fmt.Errorf("openlibrary: --subject is required")
+	}
+
+	database, err := connect(*envPath)
+	if err != nil {
+		return err
+	}
+	defer database.Close()
+
+	nodes, links, err := importers.NewOpenLibraryImporter(*baseURL, *subject).Fetch(context.Background())
+	if err != nil {
+		return err
+	}
+
+	if err := importers.UpsertNodes(database, nodes, *dryRun); err != nil {
+		return err
+	}
+	return importers.UpsertLinks(database, links, *dryRun)
+}
+
+func runSentiment(args []string) error {
+	fs := flag.NewFlagSet("sentiment", flag.ExitOnError)
+	endpoint := fs.String("endpoint", os.Getenv("SENTIMENT_API_URL"), "sentiment classifier endpoint")
+	apiKey := fs.String("api-key", os.Getenv("SENTIMENT_API_KEY"), "sentiment classifier api key")
+	envPath := fs.String("env", "app.env", "path to the app.env config file")
+	dryRun := fs.Bool("dry-run", false, "print what would be upserted without writing to the db")
+	fs.Parse(args)
+
+	if *endpoint == "" {
+		return fmt.Errorf("sentiment: --endpoint (or SENTIMENT_API_URL) is required")
+	}
+
+	database, err := connect(*envPath)
+	if err != nil {
+		return err
+	}
+	defer database.Close()
+
+	classifier := importers.NewHTTPSentimentClassifier(*endpoint, *apiKey)
+	_, links, err := importers.NewSentimentImporter(database, classifier).Fetch(context.Background())
+	if err != nil {
+		return err
+	}
+
+	return importers.UpsertLinks(database, links, *dryRun)
+}
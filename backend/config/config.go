@@ -0,0 +1,46 @@
+// Package config loads application settings from an app.env file (with
+// environment variables taking precedence) via viper.
+package config
+
+import "github.com/spf13/viper"
+
+// Config holds every setting the rest of the backend needs to wire itself
+// up. Field names match the app.env / environment variable keys directly.
+type Config struct {
+	POSTGRES_DRIVER   string
+	POSTGRES_SOURCE   string
+	HTTP_LISTEN       string
+	CORS_ORIGINS      string
+	CORS_METHODS      string
+	CORS_HEADERS      string
+	CORS_CREDENTIALS  bool
+	JWT_SECRET        string
+	CACHE_TTL_SECONDS int
+	CACHE_COOLDOWN_MS int
+}
+
+// Load reads the env file at path, falling back to real environment
+// variables for anything it doesn't set.
+func Load(path string) (*Config, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType("env")
+	v.AutomaticEnv()
+
+	if err := v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+
+	return &Config{
+		POSTGRES_DRIVER:   v.GetString("POSTGRES_DRIVER"),
+		POSTGRES_SOURCE:   v.GetString("POSTGRES_SOURCE"),
+		HTTP_LISTEN:       v.GetString("HTTP_LISTEN"),
+		CORS_ORIGINS:      v.GetString("CORS_ORIGINS"),
+		CORS_METHODS:      v.GetString("CORS_METHODS"),
+		CORS_HEADERS:      v.GetString("CORS_HEADERS"),
+		CORS_CREDENTIALS:  v.GetBool("CORS_CREDENTIALS"),
+		JWT_SECRET:        v.GetString("JWT_SECRET"),
+		CACHE_TTL_SECONDS: v.GetInt("CACHE_TTL_SECONDS"),
+		CACHE_COOLDOWN_MS: v.GetInt("CACHE_COOLDOWN_MS"),
+	}, nil
+}
@@ -0,0 +1,43 @@
+// Package db wraps the raw *sql.DB connection used by the handlers.
+package db
+
+import (
+	"database/sql"
+	"sync"
+
+	_ "github.com/lib/pq"
+)
+
+// DB wraps *sql.DB with a mutex handlers can take to serialize writes
+// (e.g. the node delete + link cleanup done together).
+type DB struct {
+	*sql.DB
+
+	mu sync.Mutex
+}
+
+// New opens a connection using driver/source and verifies it with a ping.
+func New(driver, source string) (*DB, error) {
+	conn, err := sql.Open(driver, source)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := conn.Ping(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return &DB{DB: conn}, nil
+}
+
+// Lock serializes callers that need to run multiple statements as one
+// logical write without relying solely on a SQL transaction.
+func (d *DB) Lock() {
+	d.mu.Lock()
+}
+
+// Unlock releases a lock taken with Lock.
+func (d *DB) Unlock() {
+	d.mu.Unlock()
+}
@@ -0,0 +1,15 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// graphCachePrefix namespaces every cache key the graph-read handlers use,
+// so a single write can invalidate all of them with one Invalidate call.
+const graphCachePrefix = "graph:"
+
+func (h *Handler) cacheStats(c *gin.Context) {
+	c.JSON(http.StatusOK, h.Cache.Stats())
+}
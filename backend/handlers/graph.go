@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/limpido/asterism/backend/models"
+)
+
+func (h *Handler) getAll(c *gin.Context) {
+	graph, err := h.Cache.GetOrLoad(graphCachePrefix+"all", h.loadGraph)
+	if err != nil {
+		log.Println("Error querying nodes:", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, graph)
+}
+
+func (h *Handler) loadGraph() (interface{}, error) {
+	rows, err := h.DB.Query("SELECT id, title, author, genre, year FROM nodes")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var nodes []models.Node
+	for rows.Next() {
+		var n models.Node
+		rows.Scan(&n.ID, &n.Title, &n.Author, &n.Genre, &n.Year)
+		nodes = append(nodes, n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	lRows, err := h.DB.Query("SELECT id, source_id, target_id, quote, sentiment FROM links")
+	if err != nil {
+		return nil, err
+	}
+	defer lRows.Close()
+
+	var links []models.Link
+	for lRows.Next() {
+		var l models.Link
+		lRows.Scan(&l.ID, &l.Source, &l.Target, &l.Quote, &l.Sentiment)
+		links = append(links, l)
+	}
+	if err := lRows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models.GraphData{Nodes: nodes, Links: links}, nil
+}
@@ -0,0 +1,43 @@
+// Package handlers wires the HTTP surface (gin routes) to the db package.
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/limpido/asterism/backend/cache"
+	"github.com/limpido/asterism/backend/config"
+	"github.com/limpido/asterism/backend/db"
+)
+
+// Handler holds the dependencies every route needs.
+type Handler struct {
+	DB    *db.DB
+	Cfg   *config.Config
+	Cache *cache.Cache
+}
+
+// New builds a Handler for the given db connection, config and cache.
+func New(database *db.DB, cfg *config.Config, c *cache.Cache) *Handler {
+	return &Handler{DB: database, Cfg: cfg, Cache: c}
+}
+
+// Register attaches every asterism route to r.
+func (h *Handler) Register(r *gin.Engine) {
+	r.POST("/asterism/login", h.login)
+	r.GET("/asterism/all", h.getAll)
+	r.GET("/asterism/nodes", h.listNodes)
+	r.GET("/asterism/nodes/:id", h.getNode)
+	r.GET("/asterism/cache/stats", h.cacheStats)
+
+	auth := r.Group("/asterism")
+	auth.Use(h.authMiddleware())
+	{
+		auth.POST("/nodes", h.createNode)
+		auth.PUT("/nodes/:id", h.updateNode)
+		auth.DELETE("/nodes/:id", h.deleteNode)
+
+		auth.POST("/links", h.createLink)
+		auth.PUT("/links/:id", h.updateLink)
+		auth.DELETE("/links/:id", h.deleteLink)
+	}
+}
@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/limpido/asterism/backend/models"
+)
+
+// linkInput is the writable subset of models.Link accepted from clients.
+type linkInput struct {
+	Source    int    `json:"source" binding:"required"`
+	Target    int    `json:"target" binding:"required"`
+	Quote     string `json:"quote"`
+	Sentiment string `json:"sentiment"`
+}
+
+func (h *Handler) createLink(c *gin.Context) {
+	var in linkInput
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Share deleteNode's lock so a node delete can't finish removing its
+	// links while this inserts a new one pointing at the same node.
+	h.DB.Lock()
+	defer h.DB.Unlock()
+
+	l := models.Link{Source: in.Source, Target: in.Target, Quote: in.Quote, Sentiment: in.Sentiment}
+	row := h.DB.QueryRow(
+		"INSERT INTO links (source_id, target_id, quote, sentiment) VALUES ($1, $2, $3, $4) RETURNING id",
+		l.Source, l.Target, l.Quote, l.Sentiment,
+	)
+	if err := row.Scan(&l.ID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	h.Cache.Invalidate(graphCachePrefix)
+	c.JSON(http.StatusCreated, l)
+}
+
+func (h *Handler) updateLink(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var in linkInput
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Same reasoning as createLink: don't let this repoint a link at a
+	// node that's mid-delete.
+	h.DB.Lock()
+	defer h.DB.Unlock()
+
+	res, err := h.DB.Exec(
+		"UPDATE links SET source_id = $1, target_id = $2, quote = $3, sentiment = $4 WHERE id = $5",
+		in.Source, in.Target, in.Quote, in.Sentiment, id,
+	)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "link not found"})
+		return
+	}
+
+	h.Cache.Invalidate(graphCachePrefix)
+	c.JSON(http.StatusOK, models.Link{ID: id, Source: in.Source, Target: in.Target, Quote: in.Quote, Sentiment: in.Sentiment})
+}
+
+func (h *Handler) deleteLink(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	res, err := h.DB.Exec("DELETE FROM links WHERE id = $1", id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "link not found"})
+		return
+	}
+
+	h.Cache.Invalidate(graphCachePrefix)
+	c.Status(http.StatusNoContent)
+}
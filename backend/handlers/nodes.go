@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/limpido/asterism/backend/models"
+)
+
+// nodeInput is the writable subset of models.Node accepted from clients.
+type nodeInput struct {
+	Title  string `json:"title" binding:"required"`
+	Author string `json:"author" binding:"required"`
+	Genre  string `json:"genre"`
+	Year   string `json:"year"`
+}
+
+func (h *Handler) createNode(c *gin.Context) {
+	var in nodeInput
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	n := models.Node{Title: in.Title, Author: in.Author, Genre: in.Genre, Year: in.Year}
+	row := h.DB.QueryRow(
+		"INSERT INTO nodes (title, author, genre, year) VALUES ($1, $2, $3, $4) RETURNING id",
+		n.Title, n.Author, n.Genre, n.Year,
+	)
+	if err := row.Scan(&n.ID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	h.Cache.Invalidate(graphCachePrefix)
+	c.JSON(http.StatusCreated, n)
+}
+
+func (h *Handler) updateNode(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var in nodeInput
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	res, err := h.DB.Exec(
+		"UPDATE nodes SET title = $1, author = $2, genre = $3, year = $4 WHERE id = $5",
+		in.Title, in.Author, in.Genre, in.Year, id,
+	)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "node not found"})
+		return
+	}
+
+	h.Cache.Invalidate(graphCachePrefix)
+	c.JSON(http.StatusOK, models.Node{ID: id, Title: in.Title, Author: in.Author, Genre: in.Genre, Year: in.Year})
+}
+
+func (h *Handler) deleteNode(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	// Hold the write lock across the transaction so a concurrent link write
+	// can't slip a new reference to this node in between the two deletes.
+	h.DB.Lock()
+	defer h.DB.Unlock()
+
+	tx, err := h.DB.Begin()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if _, err := tx.Exec("DELETE FROM links WHERE source_id = $1 OR target_id = $1", id); err != nil {
+		tx.Rollback()
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	res, err := tx.Exec("DELETE FROM nodes WHERE id = $1", id)
+	if err != nil {
+		tx.Rollback()
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		tx.Rollback()
+		c.JSON(http.StatusNotFound, gin.H{"error": "node not found"})
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	h.Cache.Invalidate(graphCachePrefix)
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/limpido/asterism/backend/models"
+)
+
+const defaultLimit = 20
+
+// listResponse is the envelope returned by the filtered/paginated endpoints.
+type listResponse struct {
+	Data       interface{} `json:"data"`
+	Total      int         `json:"total"`
+	NextOffset *int        `json:"next_offset,omitempty"`
+}
+
+// listNodes handles GET /asterism/nodes?author=&genre=&year_from=&year_to=&q=&limit=&offset=
+func (h *Handler) listNodes(c *gin.Context) {
+	limit := defaultLimit
+	if v, err := strconv.Atoi(c.Query("limit")); err == nil && v > 0 {
+		limit = v
+	}
+	offset := 0
+	if v, err := strconv.Atoi(c.Query("offset")); err == nil && v >= 0 {
+		offset = v
+	}
+
+	where := []string{"1 = 1"}
+	var args []interface{}
+	addFilter := func(clause string, val interface{}) {
+		args = append(args, val)
+		where = append(where, fmt.Sprintf(clause, len(args)))
+	}
+
+	if author := c.Query("author"); author != "" {
+		addFilter("author = $%d", author)
+	}
+	if genre := c.Query("genre"); genre != "" {
+		addFilter("genre = $%d", genre)
+	}
+	if yearFrom := c.Query("year_from"); yearFrom != "" {
+		addFilter("year >= $%d", yearFrom)
+	}
+	if yearTo := c.Query("year_to"); yearTo != "" {
+		addFilter("year <= $%d", yearTo)
+	}
+	if q := c.Query("q"); q != "" {
+		addFilter("title ILIKE $%d", "%"+q+"%")
+	}
+	whereClause := strings.Join(where, " AND ")
+
+	var total int
+	if err := h.DB.QueryRow("SELECT COUNT(*) FROM nodes WHERE "+whereClause, args...).Scan(&total); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	pageArgs := append(append([]interface{}{}, args...), limit, offset)
+	query := fmt.Sprintf(
+		"SELECT id, title, author, genre, year FROM nodes WHERE %s ORDER BY id LIMIT $%d OFFSET $%d",
+		whereClause, len(pageArgs)-1, len(pageArgs),
+	)
+	rows, err := h.DB.Query(query, pageArgs...)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	defer rows.Close()
+
+	nodes := []models.Node{}
+	for rows.Next() {
+		var n models.Node
+		if err := rows.Scan(&n.ID, &n.Title, &n.Author, &n.Genre, &n.Year); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		nodes = append(nodes, n)
+	}
+	if err := rows.Err(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp := listResponse{Data: nodes, Total: total}
+	if offset+len(nodes) < total {
+		next := offset + limit
+		resp.NextOffset = &next
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// nodeWithLinks is the response shape for GET /asterism/nodes/:id.
+type nodeWithLinks struct {
+	Node  models.Node   `json:"node"`
+	Links []models.Link `json:"links"`
+}
+
+// getNode handles GET /asterism/nodes/:id, returning the node plus the
+// links where it's the source or target.
+func (h *Handler) getNode(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	key := fmt.Sprintf("%snode:%d", graphCachePrefix, id)
+	result, err := h.Cache.GetOrLoad(key, func() (interface{}, error) {
+		return h.loadNodeWithLinks(id)
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			c.JSON(http.StatusNotFound, gin.H{"error": "node not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *Handler) loadNodeWithLinks(id int) (nodeWithLinks, error) {
+	var n models.Node
+	row := h.DB.QueryRow("SELECT id, title, author, genre, year FROM nodes WHERE id = $1", id)
+	if err := row.Scan(&n.ID, &n.Title, &n.Author, &n.Genre, &n.Year); err != nil {
+		return nodeWithLinks{}, err
+	}
+
+	rows, err := h.DB.Query(
+		"SELECT id, source_id, target_id, quote, sentiment FROM links WHERE source_id = $1 OR target_id = $1",
+		id,
+	)
+	if err != nil {
+		return nodeWithLinks{}, err
+	}
+	defer rows.Close()
+
+	links := []models.Link{}
+	for rows.Next() {
+		var l models.Link
+		if err := rows.Scan(&l.ID, &l.Source, &l.Target, &l.Quote, &l.Sentiment); err != nil {
+			return nodeWithLinks{}, err
+		}
+		links = append(links, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nodeWithLinks{}, err
+	}
+
+	return nodeWithLinks{Node: n, Links: links}, nil
+}
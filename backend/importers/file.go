@@ -0,0 +1,93 @@
+package importers
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/limpido/asterism/backend/models"
+)
+
+// FileImporter reads nodes and links from a local JSON or CSV file.
+type FileImporter struct {
+	Path string
+}
+
+// NewFileImporter builds a FileImporter for the file at path.
+func NewFileImporter(path string) *FileImporter {
+	return &FileImporter{Path: path}
+}
+
+func (f *FileImporter) Name() string { return "file" }
+
+func (f *FileImporter) Fetch(ctx context.Context) ([]models.Node, []models.Link, error) {
+	switch ext := strings.ToLower(filepath.Ext(f.Path)); ext {
+	case ".json":
+		return f.fetchJSON()
+	case ".csv":
+		return f.fetchCSV()
+	default:
+		return nil, nil, fmt.Errorf("importers: unsupported file extension %q", ext)
+	}
+}
+
+func (f *FileImporter) fetchJSON() ([]models.Node, []models.Link, error) {
+	raw, err := os.ReadFile(f.Path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("importers: read %s: %w", f.Path, err)
+	}
+
+	var graph models.GraphData
+	if err := json.Unmarshal(raw, &graph); err != nil {
+		return nil, nil, fmt.Errorf("importers: parse %s: %w", f.Path, err)
+	}
+
+	return graph.Nodes, graph.Links, nil
+}
+
+// fetchCSV reads a nodes CSV with the header id,title,author,genre,year.
+// Links aren't modeled in this format, so it always returns a nil link
+// slice; use the JSON format to import links from a file.
+func (f *FileImporter) fetchCSV() ([]models.Node, []models.Link, error) {
+	file, err := os.Open(f.Path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("importers: open %s: %w", f.Path, err)
+	}
+	defer file.Close()
+
+	r := csv.NewReader(file)
+	rows, err := r.ReadAll()
+	if err != nil {
+		return nil, nil, fmt.Errorf("importers: parse %s: %w", f.Path, err)
+	}
+	if len(rows) == 0 {
+		return nil, nil, nil
+	}
+
+	var nodes []models.Node
+	for _, row := range rows[1:] {
+		if len(row) < 5 {
+			return nil, nil, fmt.Errorf("importers: %s: expected 5 columns, got %d", f.Path, len(row))
+		}
+
+		id, err := strconv.Atoi(row[0])
+		if err != nil {
+			return nil, nil, fmt.Errorf("importers: %s: invalid id %q: %w", f.Path, row[0], err)
+		}
+
+		nodes = append(nodes, models.Node{
+			ID:     id,
+			Title:  row[1],
+			Author: row[2],
+			Genre:  row[3],
+			Year:   row[4],
+		})
+	}
+
+	return nodes, nil, nil
+}
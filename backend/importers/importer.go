@@ -0,0 +1,16 @@
+// Package importers fetches nodes and links from external sources so they
+// can be upserted into the graph by the asterism-import CLI.
+package importers
+
+import (
+	"context"
+
+	"github.com/limpido/asterism/backend/models"
+)
+
+// Importer fetches graph data from a single source. Implementations may
+// return nodes without links, links without nodes, or both.
+type Importer interface {
+	Name() string
+	Fetch(ctx context.Context) ([]models.Node, []models.Link, error)
+}
@@ -0,0 +1,86 @@
+package importers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/limpido/asterism/backend/models"
+)
+
+// OpenLibraryImporter pulls book metadata from Open Library's subject API
+// (https://openlibrary.org/subjects/{subject}.json) and turns each work
+// into a Node. It never produces links.
+type OpenLibraryImporter struct {
+	BaseURL string
+	Subject string
+	Client  *http.Client
+}
+
+// NewOpenLibraryImporter builds an importer for the given subject (e.g.
+// "science_fiction"). baseURL defaults to https://openlibrary.org when empty.
+func NewOpenLibraryImporter(baseURL, subject string) *OpenLibraryImporter {
+	if baseURL == "" {
+		baseURL = "https://openlibrary.org"
+	}
+	return &OpenLibraryImporter{BaseURL: baseURL, Subject: subject, Client: http.DefaultClient}
+}
+
+func (o *OpenLibraryImporter) Name() string { return "openlibrary" }
+
+type openLibrarySubjectResponse struct {
+	Works []struct {
+		Title   string `json:"title"`
+		Authors []struct {
+			Name string `json:"name"`
+		} `json:"authors"`
+		FirstPublishYear int      `json:"first_publish_year"`
+		Subject          []string `json:"subject"`
+	} `json:"works"`
+}
+
+func (o *OpenLibraryImporter) Fetch(ctx context.Context) ([]models.Node, []models.Link, error) {
+	url := fmt.Sprintf("%s/subjects/%s.json", o.BaseURL, o.Subject)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("importers: build request for %s: %w", url, err)
+	}
+
+	resp, err := o.Client.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("importers: fetch %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("importers: fetch %s: unexpected status %d", url, resp.StatusCode)
+	}
+
+	var payload openLibrarySubjectResponse
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return nil, nil, fmt.Errorf("importers: decode %s: %w", url, err)
+	}
+
+	nodes := make([]models.Node, 0, len(payload.Works))
+	for _, w := range payload.Works {
+		var author string
+		if len(w.Authors) > 0 {
+			author = w.Authors[0].Name
+		}
+		var genre string
+		if len(w.Subject) > 0 {
+			genre = w.Subject[0]
+		}
+
+		nodes = append(nodes, models.Node{
+			Title:  w.Title,
+			Author: author,
+			Genre:  genre,
+			Year:   strconv.Itoa(w.FirstPublishYear),
+		})
+	}
+
+	return nodes, nil, nil
+}
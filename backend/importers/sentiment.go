@@ -0,0 +1,61 @@
+package importers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/limpido/asterism/backend/db"
+	"github.com/limpido/asterism/backend/models"
+)
+
+// SentimentClassifier scores a single quote, e.g. via an LLM call.
+type SentimentClassifier interface {
+	Classify(ctx context.Context, quote string) (string, error)
+}
+
+// SentimentImporter doesn't add new graph data — it re-emits the links
+// that are missing a sentiment with that field filled in by Classifier, so
+// it can be upserted back over the existing rows.
+type SentimentImporter struct {
+	DB         *db.DB
+	Classifier SentimentClassifier
+}
+
+// NewSentimentImporter builds a SentimentImporter backed by database and
+// classifier.
+func NewSentimentImporter(database *db.DB, classifier SentimentClassifier) *SentimentImporter {
+	return &SentimentImporter{DB: database, Classifier: classifier}
+}
+
+func (s *SentimentImporter) Name() string { return "sentiment" }
+
+func (s *SentimentImporter) Fetch(ctx context.Context) ([]models.Node, []models.Link, error) {
+	rows, err := s.DB.QueryContext(ctx,
+		"SELECT id, source_id, target_id, quote, sentiment FROM links WHERE sentiment = '' OR sentiment IS NULL")
+	if err != nil {
+		return nil, nil, fmt.Errorf("importers: query links missing sentiment: %w", err)
+	}
+	defer rows.Close()
+
+	var links []models.Link
+	for rows.Next() {
+		var l models.Link
+		if err := rows.Scan(&l.ID, &l.Source, &l.Target, &l.Quote, &l.Sentiment); err != nil {
+			return nil, nil, fmt.Errorf("importers: scan link: %w", err)
+		}
+		links = append(links, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("importers: iterate links missing sentiment: %w", err)
+	}
+
+	for i, l := range links {
+		sentiment, err := s.Classifier.Classify(ctx, l.Quote)
+		if err != nil {
+			return nil, nil, fmt.Errorf("importers: classify link %d: %w", l.ID, err)
+		}
+		links[i].Sentiment = sentiment
+	}
+
+	return nil, links, nil
+}
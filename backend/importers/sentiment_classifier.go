@@ -0,0 +1,58 @@
+package importers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// HTTPSentimentClassifier calls an external LLM-backed classification
+// endpoint that accepts {"quote": "..."} and returns {"sentiment": "..."}.
+type HTTPSentimentClassifier struct {
+	Endpoint string
+	APIKey   string
+	Client   *http.Client
+}
+
+// NewHTTPSentimentClassifier builds a classifier for the given endpoint.
+// apiKey may be empty if the endpoint doesn't require auth.
+func NewHTTPSentimentClassifier(endpoint, apiKey string) *HTTPSentimentClassifier {
+	return &HTTPSentimentClassifier{Endpoint: endpoint, APIKey: apiKey, Client: http.DefaultClient}
+}
+
+func (c *HTTPSentimentClassifier) Classify(ctx context.Context, quote string) (string, error) {
+	body, err := json.Marshal(map[string]string{"quote": quote})
+	if err != nil {
+		return "", fmt.Errorf("importers: encode classify request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("importers: build classify request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.APIKey)
+	}
+
+	resp, err := c.Client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("importers: classify request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("importers: classify request: unexpected status %d", resp.StatusCode)
+	}
+
+	var out struct {
+		Sentiment string `json:"sentiment"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return "", fmt.Errorf("importers: decode classify response: %w", err)
+	}
+
+	return out.Sentiment, nil
+}
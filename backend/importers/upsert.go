@@ -0,0 +1,69 @@
+package importers
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/limpido/asterism/backend/db"
+	"github.com/limpido/asterism/backend/models"
+)
+
+// UpsertNodes inserts nodes or, on an id conflict, updates them in place.
+// Nodes with ID == 0 (e.g. from an importer that scrapes metadata and
+// never assigns one) are always inserted as new rows, since there's no id
+// to key a conflict on and a shared ID of 0 would otherwise overwrite the
+// same row for every such node. With dryRun it only logs what it would
+// have written.
+func UpsertNodes(database *db.DB, nodes []models.Node, dryRun bool) error {
+	for _, n := range nodes {
+		if dryRun {
+			log.Printf("dry-run: would upsert node %d %q", n.ID, n.Title)
+			continue
+		}
+
+		var err error
+		if n.ID == 0 {
+			_, err = database.Exec(
+				`INSERT INTO nodes (title, author, genre, year) VALUES ($1, $2, $3, $4)`,
+				n.Title, n.Author, n.Genre, n.Year,
+			)
+		} else {
+			_, err = database.Exec(
+				`INSERT INTO nodes (id, title, author, genre, year)
+				 VALUES ($1, $2, $3, $4, $5)
+				 ON CONFLICT (id) DO UPDATE SET
+				   title = EXCLUDED.title, author = EXCLUDED.author,
+				   genre = EXCLUDED.genre, year = EXCLUDED.year`,
+				n.ID, n.Title, n.Author, n.Genre, n.Year,
+			)
+		}
+		if err != nil {
+			return fmt.Errorf("importers: upsert node %d: %w", n.ID, err)
+		}
+	}
+	return nil
+}
+
+// UpsertLinks inserts links or, on a (source_id, target_id) conflict,
+// updates them in place. With dryRun it only logs what it would have
+// written.
+func UpsertLinks(database *db.DB, links []models.Link, dryRun bool) error {
+	for _, l := range links {
+		if dryRun {
+			log.Printf("dry-run: would upsert link %d -> %d", l.Source, l.Target)
+			continue
+		}
+
+		_, err := database.Exec(
+			`INSERT INTO links (source_id, target_id, quote, sentiment)
+			 VALUES ($1, $2, $3, $4)
+			 ON CONFLICT (source_id, target_id) DO UPDATE SET
+			   quote = EXCLUDED.quote, sentiment = EXCLUDED.sentiment`,
+			l.Source, l.Target, l.Quote, l.Sentiment,
+		)
+		if err != nil {
+			return fmt.Errorf("importers: upsert link %d -> %d: %w", l.Source, l.Target, err)
+		}
+	}
+	return nil
+}
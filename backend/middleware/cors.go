@@ -0,0 +1,74 @@
+// Package middleware holds gin middleware shared across the asterism API.
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/limpido/asterism/backend/config"
+)
+
+const (
+	defaultCORSMethods = "GET,POST,PUT,DELETE,OPTIONS"
+	defaultCORSHeaders = "Content-Type,Authorization"
+)
+
+// CORS builds a spec-compliant CORS middleware from cfg: it checks the
+// request Origin against cfg.CORS_ORIGINS, short-circuits preflight
+// OPTIONS requests with 204, and sets Allow-Methods/Headers/Credentials.
+// With no origins configured, every cross-origin request is rejected.
+func CORS(cfg *config.Config) gin.HandlerFunc {
+	allowed := make(map[string]bool)
+	for _, origin := range splitAndTrim(cfg.CORS_ORIGINS) {
+		allowed[origin] = true
+	}
+
+	methods := cfg.CORS_METHODS
+	if methods == "" {
+		methods = defaultCORSMethods
+	}
+	headers := cfg.CORS_HEADERS
+	if headers == "" {
+		headers = defaultCORSHeaders
+	}
+
+	return func(c *gin.Context) {
+		c.Writer.Header().Add("Vary", "Origin")
+
+		origin := c.GetHeader("Origin")
+		if origin != "" && allowed[origin] {
+			// Echo the matched origin rather than "*" so the response is
+			// valid even when Allow-Credentials is set.
+			c.Writer.Header().Set("Access-Control-Allow-Origin", origin)
+			c.Writer.Header().Set("Access-Control-Allow-Methods", methods)
+			c.Writer.Header().Set("Access-Control-Allow-Headers", headers)
+			if cfg.CORS_CREDENTIALS {
+				c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
+			}
+		}
+
+		if c.Request.Method == http.MethodOptions {
+			c.AbortWithStatus(http.StatusNoContent)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func splitAndTrim(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	parts := strings.Split(s, ",")
+	out := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if trimmed := strings.TrimSpace(p); trimmed != "" {
+			out = append(out, trimmed)
+		}
+	}
+	return out
+}
@@ -0,0 +1,25 @@
+// Package models holds the domain types shared by the handlers and db
+// packages. They mirror the shapes the React frontend expects.
+package models
+
+// GraphData structures match your React types
+type GraphData struct {
+	Nodes []Node `json:"nodes"`
+	Links []Link `json:"links"`
+}
+
+type Node struct {
+	ID     int    `json:"id"`
+	Title  string `json:"title"`
+	Author string `json:"author"`
+	Genre  string `json:"genre"`
+	Year   string `json:"year"`
+}
+
+type Link struct {
+	ID        int    `json:"id"`
+	Source    int    `json:"source"`
+	Target    int    `json:"target"`
+	Quote     string `json:"quote"`
+	Sentiment string `json:"sentiment"`
+}